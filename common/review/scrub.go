@@ -0,0 +1,32 @@
+// Package review holds small helpers shared by provider review scrapers.
+package review
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ratingPattern matches the leading "N/10", "N.N/10", or bare "N" rating
+// that providers tend to prefix a review's text with.
+var ratingPattern = regexp.MustCompile(`^\s*(\d+(?:\.\d+)?)\s*/?\s*(?:10)?\s*[-:]?\s*`)
+
+// ScrubReview splits a raw, scraped review string into its leading numeric
+// rating (as parsed, not rescaled) and the remaining body text, trimmed and
+// with runs of whitespace collapsed to single spaces. If no rating prefix is
+// found, rating is 0 and body is the whole input, trimmed and collapsed the
+// same way.
+func ScrubReview(text string) (rating float64, body string) {
+	text = strings.TrimSpace(text)
+	if m := ratingPattern.FindStringSubmatch(text); m != nil {
+		if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+			rating = v
+			text = strings.TrimSpace(text[len(m[0]):])
+		}
+	}
+	return rating, collapseWhitespace(text)
+}
+
+func collapseWhitespace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
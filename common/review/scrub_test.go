@@ -0,0 +1,30 @@
+package review
+
+import "testing"
+
+func TestScrubReview(t *testing.T) {
+	cases := []struct {
+		name       string
+		in         string
+		wantRating float64
+		wantBody   string
+	}{
+		{"slash ten", "8/10 - Great movie, would watch again.", 8, "Great movie, would watch again."},
+		{"decimal slash ten", "7.5/10 Solid entry in the series.", 7.5, "Solid entry in the series."},
+		{"bare number prefix", "9 Excellent.", 9, "Excellent."},
+		{"no rating prefix", "Just a plain review with no score.", 0, "Just a plain review with no score."},
+		{"collapses whitespace", "6/10   has   \n\n  extra   whitespace", 6, "has extra whitespace"},
+		{"empty input", "", 0, ""},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rating, body := ScrubReview(c.in)
+			if rating != c.wantRating {
+				t.Errorf("ScrubReview(%q) rating = %v, want %v", c.in, rating, c.wantRating)
+			}
+			if body != c.wantBody {
+				t.Errorf("ScrubReview(%q) body = %q, want %q", c.in, body, c.wantBody)
+			}
+		})
+	}
+}
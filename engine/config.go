@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"fmt"
+
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+	"golang.org/x/time/rate"
+)
+
+// configureProvider applies cfg to provider (if it's a
+// ConfigurableMovieProvider) and (re)builds its rate limiter from the
+// "qps"/"burst" entries, if present.
+func (e *Engine) configureProvider(provider javtube.MovieProvider, cfg map[string]any) error {
+	if configurable, ok := provider.(javtube.ConfigurableMovieProvider); ok {
+		if err := configurable.Configure(cfg); err != nil {
+			return fmt.Errorf("configure %s: %w", provider.Name(), err)
+		}
+	}
+
+	limit := rate.Inf
+	burst := 1
+	if qps, ok := numericField(cfg, "qps"); ok && qps > 0 {
+		limit = rate.Limit(qps)
+	}
+	if b, ok := numericField(cfg, "burst"); ok && b > 0 {
+		burst = int(b)
+	}
+
+	e.limitersMu.Lock()
+	e.limiters[provider.Name()] = rate.NewLimiter(limit, burst)
+	e.limitersMu.Unlock()
+	return nil
+}
+
+// numericField reads a numeric config value regardless of whether it was
+// supplied as an int or a float (both are common coming from JSON/YAML).
+func numericField(cfg map[string]any, key string) (float64, bool) {
+	switch v := cfg[key].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+// SetProviderConfig hot-updates the named provider's configuration,
+// re-running Configure (which is expected to reset internal HTTP clients
+// and invalidate session cookies) and rebuilding its rate limiter.
+func (e *Engine) SetProviderConfig(name string, cfg map[string]any) error {
+	provider, ok := e.movieProviders[name]
+	if !ok {
+		return fmt.Errorf("provider not found: %s", name)
+	}
+	return e.configureProvider(provider, cfg)
+}
+
+// limiter returns the rate limiter for name, defaulting to unlimited if the
+// provider was never configured with a QPS.
+func (e *Engine) limiter(name string) *rate.Limiter {
+	e.limitersMu.RLock()
+	defer e.limitersMu.RUnlock()
+	if l, ok := e.limiters[name]; ok {
+		return l
+	}
+	return rate.NewLimiter(rate.Inf, 1)
+}
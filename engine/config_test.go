@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/javtube/javtube-sdk-go/model"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+	"golang.org/x/time/rate"
+)
+
+// fakeProvider is a minimal javtube.MovieProvider that also accepts runtime
+// configuration, for exercising configureProvider/SetProviderConfig without
+// a real scraper or database.
+type fakeProvider struct {
+	name    string
+	cfgErr  error
+	lastCfg map[string]any
+}
+
+func (p *fakeProvider) Name() string                 { return p.name }
+func (p *fakeProvider) Priority() int                { return 0 }
+func (p *fakeProvider) NormalizeID(id string) string { return id }
+func (p *fakeProvider) GetMovieInfoByID(id string) (*model.MovieInfo, error) {
+	return &model.MovieInfo{ID: id, Provider: p.name}, nil
+}
+func (p *fakeProvider) Configure(cfg map[string]any) error {
+	p.lastCfg = cfg
+	return p.cfgErr
+}
+
+func newTestEngine(providers ...javtube.MovieProvider) *Engine {
+	e := &Engine{
+		movieProviders: make(map[string]javtube.MovieProvider, len(providers)),
+		limiters:       make(map[string]*rate.Limiter, len(providers)),
+	}
+	for _, p := range providers {
+		e.movieProviders[p.Name()] = p
+	}
+	return e
+}
+
+func TestNumericField(t *testing.T) {
+	cfg := map[string]any{"int": 3, "float": 2.5, "other": "nope"}
+	if v, ok := numericField(cfg, "int"); !ok || v != 3 {
+		t.Fatalf("numericField(int) = %v, %v, want 3, true", v, ok)
+	}
+	if v, ok := numericField(cfg, "float"); !ok || v != 2.5 {
+		t.Fatalf("numericField(float) = %v, %v, want 2.5, true", v, ok)
+	}
+	if _, ok := numericField(cfg, "other"); ok {
+		t.Fatal("numericField(other) should report not-ok for a non-numeric value")
+	}
+	if _, ok := numericField(cfg, "missing"); ok {
+		t.Fatal("numericField(missing) should report not-ok for an absent key")
+	}
+}
+
+func TestEngineLimiterDefaultsToUnlimited(t *testing.T) {
+	e := newTestEngine()
+	l := e.limiter("UNCONFIGURED")
+	if l.Limit() != rate.Inf {
+		t.Fatalf("limiter(unconfigured) = %v, want rate.Inf", l.Limit())
+	}
+}
+
+func TestSetProviderConfigRebuildsLimiter(t *testing.T) {
+	p := &fakeProvider{name: "FAKE"}
+	e := newTestEngine(p)
+	e.limitersMu = sync.RWMutex{}
+
+	if err := e.SetProviderConfig("FAKE", map[string]any{"qps": 2, "burst": 4}); err != nil {
+		t.Fatalf("SetProviderConfig() error = %v", err)
+	}
+	if got := p.lastCfg["qps"]; got != 2 {
+		t.Fatalf("Configure was called with qps = %v, want 2", got)
+	}
+	l := e.limiter("FAKE")
+	if l.Limit() != rate.Limit(2) || l.Burst() != 4 {
+		t.Fatalf("limiter(FAKE) = %v/%v, want 2/4", l.Limit(), l.Burst())
+	}
+
+	// A second call must rebuild the limiter from the new config, not reuse
+	// the first one.
+	if err := e.SetProviderConfig("FAKE", map[string]any{"qps": 10, "burst": 1}); err != nil {
+		t.Fatalf("SetProviderConfig() second call error = %v", err)
+	}
+	l = e.limiter("FAKE")
+	if l.Limit() != rate.Limit(10) || l.Burst() != 1 {
+		t.Fatalf("limiter(FAKE) after rebuild = %v/%v, want 10/1", l.Limit(), l.Burst())
+	}
+}
+
+func TestSetProviderConfigUnknownProvider(t *testing.T) {
+	e := newTestEngine()
+	if err := e.SetProviderConfig("MISSING", nil); err == nil {
+		t.Fatal("SetProviderConfig(unknown) should return an error")
+	}
+}
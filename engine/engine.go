@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/javtube/javtube-sdk-go/engine/jobs"
+	"github.com/javtube/javtube-sdk-go/engine/library"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+)
+
+// Engine ties together the registered providers and the database used to
+// cache and query their results.
+type Engine struct {
+	db             *gorm.DB
+	movieProviders map[string]javtube.MovieProvider
+
+	// reviewers holds review-only scrapers registered via RegisterReviewer,
+	// for providers that don't implement the full MovieProvider interface.
+	// movieProviders that happen to implement javtube.MovieReviewer are
+	// also consulted; see allReviewers.
+	reviewers map[string]javtube.MovieReviewer
+
+	limitersMu sync.RWMutex
+	limiters   map[string]*rate.Limiter
+
+	jobs    *jobs.Queue
+	library *library.Library // nil unless WithLibrary was called.
+}
+
+// New creates an Engine backed by db, registering and configuring all given
+// providers. cfg may be nil, in which case providers run unconfigured and
+// unrate-limited.
+func New(db *gorm.DB, cfg javtube.Config, providers ...javtube.MovieProvider) (*Engine, error) {
+	e := &Engine{
+		db:             db,
+		movieProviders: make(map[string]javtube.MovieProvider, len(providers)),
+		reviewers:      make(map[string]javtube.MovieReviewer),
+		limiters:       make(map[string]*rate.Limiter, len(providers)),
+	}
+	for _, provider := range providers {
+		e.movieProviders[provider.Name()] = provider
+		if err := e.configureProvider(provider, cfg[provider.Name()]); err != nil {
+			return nil, err
+		}
+	}
+
+	queue, err := jobs.NewQueue(db)
+	if err != nil {
+		return nil, err
+	}
+	e.jobs = queue
+	e.registerJobHandlers()
+
+	return e, nil
+}
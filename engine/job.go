@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/javtube/javtube-sdk-go/engine/jobs"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+)
+
+type movieRefreshPayload struct {
+	Provider string `json:"provider"`
+	ID       string `json:"id"`
+}
+
+type searchCrawlPayload struct {
+	Keyword string `json:"keyword"`
+}
+
+type reviewFetchPayload struct {
+	Provider string `json:"provider"`
+	ID       string `json:"id"`
+}
+
+// EnqueueMovieRefresh schedules an asynchronous re-fetch of a single movie's
+// info from the given provider.
+func (e *Engine) EnqueueMovieRefresh(providerName, id string) error {
+	_, err := e.jobs.Enqueue(jobs.KindMovieRefresh, movieRefreshPayload{
+		Provider: providerName,
+		ID:       id,
+	})
+	return err
+}
+
+// EnqueueSearchCrawl schedules an asynchronous SearchMovieAll for keyword.
+func (e *Engine) EnqueueSearchCrawl(keyword string) error {
+	_, err := e.jobs.Enqueue(jobs.KindSearchCrawl, searchCrawlPayload{
+		Keyword: keyword,
+	})
+	return err
+}
+
+// EnqueueReviewFetch schedules an asynchronous review fetch for a movie.
+func (e *Engine) EnqueueReviewFetch(providerName, id string) error {
+	_, err := e.jobs.Enqueue(jobs.KindReviewFetch, reviewFetchPayload{
+		Provider: providerName,
+		ID:       id,
+	})
+	return err
+}
+
+// RunWorkers starts n background workers draining the job queue until ctx is
+// canceled.
+func (e *Engine) RunWorkers(ctx context.Context, n int) {
+	e.jobs.RunWorkers(ctx, n)
+}
+
+// JobQueueHandler returns an http.Handler exposing queue depth and requeue
+// endpoints for operational inspection.
+func (e *Engine) JobQueueHandler() http.Handler {
+	return e.jobs.Handler()
+}
+
+// registerJobHandlers wires each job kind to the Engine method that performs
+// the corresponding synchronous work.
+func (e *Engine) registerJobHandlers() {
+	e.jobs.Register(jobs.KindMovieRefresh, func(payload []byte) error {
+		var p movieRefreshPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return err
+		}
+		provider, ok := e.movieProviders[p.Provider]
+		if !ok {
+			return fmt.Errorf("provider not found: %s", p.Provider)
+		}
+		// Scheduled refreshes are exactly the rate-limit-respecting crawl
+		// workload the limiter exists for; honor it here too, not just in
+		// the searchMovieAll fan-out.
+		if err := e.limiter(provider.Name()).Wait(context.Background()); err != nil {
+			return err
+		}
+		_, err := e.getMovieInfoByID(p.ID, provider, false /* force refresh */)
+		return err
+	})
+	e.jobs.Register(jobs.KindSearchCrawl, func(payload []byte) error {
+		var p searchCrawlPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return err
+		}
+		_, err := e.SearchMovieAll(p.Keyword, javtube.SearchOptions{})
+		return err
+	})
+	e.jobs.Register(jobs.KindReviewFetch, func(payload []byte) error {
+		var p reviewFetchPayload
+		if err := json.Unmarshal(payload, &p); err != nil {
+			return err
+		}
+		_, err := e.GetMovieReviews(p.ID, p.Provider, false)
+		return err
+	})
+}
@@ -0,0 +1,46 @@
+package jobs
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// Handler returns an http.Handler exposing basic queue introspection and
+// operator actions:
+//
+//	GET  /depth         -> {"depth": n}
+//	POST /requeue?id=13 -> requeues a failed job
+func (q *Queue) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/depth", q.handleDepth)
+	mux.HandleFunc("/requeue", q.handleRequeue)
+	return mux
+}
+
+func (q *Queue) handleDepth(w http.ResponseWriter, r *http.Request) {
+	depth, err := q.Depth()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int64{"depth": depth})
+}
+
+func (q *Queue) handleRequeue(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.ParseUint(r.URL.Query().Get("id"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid id", http.StatusBadRequest)
+		return
+	}
+	if err = q.Requeue(id); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,130 @@
+// Package jobs implements a persistent, GORM-backed background job queue
+// used to offload slow provider fan-outs (movie refreshes, search crawls,
+// review fetches) so they survive process restarts.
+package jobs
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/javtube/javtube-sdk-go/model"
+	"gorm.io/gorm"
+)
+
+// Well-known job kinds handled by the engine.
+const (
+	KindMovieRefresh = "movie_refresh"
+	KindSearchCrawl  = "search_crawl"
+	KindReviewFetch  = "review_fetch"
+)
+
+// Handler executes the work described by a Job's payload.
+type Handler func(payload []byte) error
+
+// Queue is a durable job queue backed by the model.Job table. Workers claim
+// rows with a token-based UPDATE so the same implementation works on both
+// sqlite (no SELECT ... FOR UPDATE SKIP LOCKED) and server databases.
+type Queue struct {
+	db       *gorm.DB
+	handlers map[string]Handler
+}
+
+// NewQueue creates a Queue backed by db, auto-migrating the Job table.
+func NewQueue(db *gorm.DB) (*Queue, error) {
+	if err := db.AutoMigrate(&model.Job{}); err != nil {
+		return nil, err
+	}
+	return &Queue{
+		db:       db,
+		handlers: make(map[string]Handler),
+	}, nil
+}
+
+// Register associates kind with the Handler invoked to process its Jobs.
+func (q *Queue) Register(kind string, handler Handler) {
+	q.handlers[kind] = handler
+}
+
+// Enqueue inserts a new pending Job of the given kind, JSON-encoding payload.
+func (q *Queue) Enqueue(kind string, payload any) (*model.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	job := &model.Job{
+		Kind:     kind,
+		Payload:  string(data),
+		Status:   model.JobStatusPending,
+		RunAfter: time.Now(),
+	}
+	if err = q.db.Create(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// Depth returns the number of jobs currently awaiting execution.
+func (q *Queue) Depth() (int64, error) {
+	var count int64
+	err := q.db.Model(&model.Job{}).
+		Where("status = ?", model.JobStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+// Requeue resets a failed Job so it is eligible for immediate re-execution,
+// giving it a fresh run at the full retry ladder (attempts reset to 0).
+func (q *Queue) Requeue(id uint64) error {
+	result := q.db.Model(&model.Job{}).
+		Where("id = ? AND status = ?", id, model.JobStatusFailed).
+		Updates(map[string]any{
+			"status":     model.JobStatusPending,
+			"run_after":  time.Now(),
+			"last_error": "",
+			"attempts":   0,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// claim atomically marks one eligible pending Job as running and returns it,
+// or returns (nil, nil) if none are due.
+func (q *Queue) claim() (*model.Job, error) {
+	token := uuid.NewString()
+
+	sub := q.db.Model(&model.Job{}).
+		Select("id").
+		Where("status = ? AND run_after <= ?", model.JobStatusPending, time.Now()).
+		Order("run_after").
+		Limit(1)
+
+	// Re-check status = pending on the outer UPDATE: the subquery only
+	// picks a candidate id, it doesn't lock it, so two workers can both
+	// select the same row before either commits. Guarding the outer
+	// statement too means only the first commit's row matches and the
+	// second racer's UPDATE affects zero rows.
+	result := q.db.Model(&model.Job{}).
+		Where("id = (?) AND status = ?", sub, model.JobStatusPending).
+		Updates(map[string]any{
+			"status":      model.JobStatusRunning,
+			"claim_token": token,
+		})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	job := new(model.Job)
+	if err := q.db.Where("claim_token = ?", token).First(job).Error; err != nil {
+		return nil, err
+	}
+	return job, nil
+}
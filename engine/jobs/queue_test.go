@@ -0,0 +1,72 @@
+package jobs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/javtube/javtube-sdk-go/model"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func newTestQueue(t *testing.T) *Queue {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+	q, err := NewQueue(db)
+	if err != nil {
+		t.Fatalf("NewQueue() error = %v", err)
+	}
+	return q
+}
+
+func TestRequeueResetsAttemptsAndStatus(t *testing.T) {
+	q := newTestQueue(t)
+	job, err := q.Enqueue(KindMovieRefresh, map[string]string{"id": "1"})
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	if err := q.db.Model(&model.Job{}).Where("id = ?", job.ID).Updates(map[string]any{
+		"status":     model.JobStatusFailed,
+		"attempts":   maxAttempts,
+		"last_error": "boom",
+	}).Error; err != nil {
+		t.Fatalf("parking job as failed: %v", err)
+	}
+
+	if err := q.Requeue(job.ID); err != nil {
+		t.Fatalf("Requeue() error = %v", err)
+	}
+
+	got := new(model.Job)
+	if err := q.db.First(got, job.ID).Error; err != nil {
+		t.Fatalf("reloading job: %v", err)
+	}
+	if got.Status != model.JobStatusPending {
+		t.Errorf("Status = %v, want %v", got.Status, model.JobStatusPending)
+	}
+	if got.Attempts != 0 {
+		t.Errorf("Attempts = %d, want 0 — a requeued job must get the full retry ladder again", got.Attempts)
+	}
+	if got.LastError != "" {
+		t.Errorf("LastError = %q, want empty", got.LastError)
+	}
+	if got.RunAfter.After(time.Now()) {
+		t.Errorf("RunAfter = %v, want immediately eligible", got.RunAfter)
+	}
+}
+
+func TestRequeueNonFailedJob(t *testing.T) {
+	q := newTestQueue(t)
+	job, err := q.Enqueue(KindMovieRefresh, map[string]string{"id": "1"})
+	if err != nil {
+		t.Fatalf("Enqueue() error = %v", err)
+	}
+
+	if err := q.Requeue(job.ID); err != gorm.ErrRecordNotFound {
+		t.Fatalf("Requeue(pending job) error = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+}
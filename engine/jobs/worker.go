@@ -0,0 +1,110 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/javtube/javtube-sdk-go/model"
+)
+
+// maxBackoff caps the delay between retries of a failing Job.
+const maxBackoff = 10 * time.Minute
+
+// maxAttempts is how many times a Job is attempted before it is parked in
+// JobStatusFailed, where it waits for an operator to Requeue it.
+const maxAttempts = 5
+
+// backoff returns the delay before a Job becomes eligible again after its
+// nth failed attempt, growing exponentially up to maxBackoff.
+func backoff(attempts int) time.Duration {
+	secs := math.Pow(2, float64(attempts))
+	if secs > maxBackoff.Seconds() {
+		return maxBackoff
+	}
+	return time.Duration(secs) * time.Second
+}
+
+// RunWorkers starts n worker goroutines that claim and execute Jobs until
+// ctx is canceled. It blocks until all workers have returned.
+func (q *Queue) RunWorkers(ctx context.Context, n int) {
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			q.workerLoop(ctx)
+		}()
+	}
+	wg.Wait()
+}
+
+func (q *Queue) workerLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			job, err := q.claim()
+			if err != nil || job == nil {
+				continue
+			}
+			q.execute(job)
+		}
+	}
+}
+
+func (q *Queue) execute(job *model.Job) {
+	handler, ok := q.handlers[job.Kind]
+	if !ok {
+		q.fail(job, fmt.Errorf("jobs: no handler registered for kind %q", job.Kind))
+		return
+	}
+	if err := q.runHandler(handler, job); err != nil {
+		q.fail(job, err)
+		return
+	}
+	q.db.Model(job).Updates(map[string]any{
+		"status": model.JobStatusDone,
+	}) // ignore error
+}
+
+// runHandler invokes handler, recovering any panic into an error so a bad
+// payload or a bug in one handler fails just that job instead of taking
+// down the whole worker pool.
+func (q *Queue) runHandler(handler Handler, job *model.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("jobs: handler for kind %q panicked: %v", job.Kind, r)
+		}
+	}()
+	return handler([]byte(job.Payload))
+}
+
+func (q *Queue) fail(job *model.Job, err error) {
+	job.Attempts++
+	log.Printf("jobs: job %d (%s) failed on attempt %d: %v", job.ID, job.Kind, job.Attempts, err)
+
+	// Once a Job exhausts its retries, park it in JobStatusFailed instead of
+	// rescheduling forever; Requeue is how it gets another chance.
+	if job.Attempts >= maxAttempts {
+		q.db.Model(job).Updates(map[string]any{
+			"status":     model.JobStatusFailed,
+			"attempts":   job.Attempts,
+			"last_error": err.Error(),
+		}) // ignore error
+		return
+	}
+
+	q.db.Model(job).Updates(map[string]any{
+		"status":     model.JobStatusPending,
+		"attempts":   job.Attempts,
+		"last_error": err.Error(),
+		"run_after":  time.Now().Add(backoff(job.Attempts)),
+	}) // ignore error
+}
@@ -0,0 +1,75 @@
+package engine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/javtube/javtube-sdk-go/common/number"
+	"github.com/javtube/javtube-sdk-go/engine/library"
+	"github.com/javtube/javtube-sdk-go/model"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+)
+
+// errLibraryNotConfigured is returned by the library methods below when
+// called before WithLibrary.
+var errLibraryNotConfigured = errors.New("engine: library not configured, call WithLibrary first")
+
+// WithLibrary enables local-library indexing over dirs, resolving each
+// discovered file's derived number through e's providers/DB the same way
+// SearchMovieAll does.
+func (e *Engine) WithLibrary(dirs []string) error {
+	lib, err := library.New(e.db, dirs, e.resolveLocalFile)
+	if err != nil {
+		return err
+	}
+	e.library = lib
+	return nil
+}
+
+// resolveLocalFile matches num (extracted from a filename) against every
+// registered provider, keeping the top hit of SearchMovieAll's default
+// ranking (similarity weighted by provider priority). The returned score is
+// num's similarity against the matched number, so a weak fuzzy hit is never
+// reported with the same confidence as an exact one.
+func (e *Engine) resolveLocalFile(_, num string) (movieID, provider string, score float64) {
+	results, err := e.SearchMovieAll(num, javtube.SearchOptions{Lazy: true, MaxResults: 1})
+	if err != nil || len(results) == 0 {
+		return "", "", 0
+	}
+	best := results[0]
+	return best.ID, best.Provider, number.Similarity(num, best.Number)
+}
+
+// RescanLibrary performs a full pass over the configured library
+// directories, indexing every video file found.
+func (e *Engine) RescanLibrary(ctx context.Context) error {
+	if e.library == nil {
+		return errLibraryNotConfigured
+	}
+	return e.library.RescanAll(ctx)
+}
+
+// WatchLibrary watches the configured library directories for changes until
+// ctx is canceled, re-indexing incrementally.
+func (e *Engine) WatchLibrary(ctx context.Context) error {
+	if e.library == nil {
+		return errLibraryNotConfigured
+	}
+	return e.library.Watch(ctx)
+}
+
+// ResolveLocalFile returns the LocalFile indexed for path.
+func (e *Engine) ResolveLocalFile(path string) (*model.LocalFile, error) {
+	if e.library == nil {
+		return nil, errLibraryNotConfigured
+	}
+	return e.library.ResolveLocalFile(path)
+}
+
+// FindLocalFiles returns every LocalFile matched to movieID/provider.
+func (e *Engine) FindLocalFiles(movieID, provider string) ([]*model.LocalFile, error) {
+	if e.library == nil {
+		return nil, errLibraryNotConfigured
+	}
+	return e.library.FindLocalFiles(movieID, provider)
+}
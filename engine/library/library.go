@@ -0,0 +1,115 @@
+// Package library indexes a local video collection: it scans configured
+// directories, derives a JAV number from each filename, resolves that
+// number against the engine's providers/DB, and records the match in the
+// model.LocalFile table so metadata and on-disk files can be looked up in
+// either direction.
+package library
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/javtube/javtube-sdk-go/common/number"
+	"github.com/javtube/javtube-sdk-go/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// videoExts lists the file extensions considered indexable video files.
+var videoExts = map[string]bool{
+	".mp4": true, ".mkv": true, ".avi": true, ".wmv": true, ".ts": true, ".m2ts": true,
+}
+
+// Resolve looks up a number derived from a filename against the engine's
+// providers/DB and reports the best match, if any. A zero score means no
+// match was found.
+type Resolve func(path, num string) (movieID, provider string, score float64)
+
+// Library scans and watches a set of directories, indexing video files into
+// the db as they appear.
+type Library struct {
+	db      *gorm.DB
+	dirs    []string
+	resolve Resolve
+}
+
+// New creates a Library over dirs, using resolve to match a derived number
+// to metadata.
+func New(db *gorm.DB, dirs []string, resolve Resolve) (*Library, error) {
+	if err := db.AutoMigrate(&model.LocalFile{}); err != nil {
+		return nil, err
+	}
+	return &Library{db: db, dirs: dirs, resolve: resolve}, nil
+}
+
+// RescanAll walks every configured directory and indexes each video file it
+// finds, stopping early if ctx is canceled.
+func (l *Library) RescanAll(ctx context.Context) error {
+	for _, dir := range l.dirs {
+		err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if d.IsDir() || !videoExts[filepath.Ext(path)] {
+				return nil
+			}
+			return l.indexPath(path)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexPath extracts a number from path's filename, resolves it, and
+// upserts the resulting model.LocalFile row.
+func (l *Library) indexPath(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	file := &model.LocalFile{
+		Path:  path,
+		Size:  info.Size(),
+		MTime: info.ModTime(),
+	}
+	base := filepath.Base(path)
+	if num := number.Trim(base[:len(base)-len(filepath.Ext(base))]); num != "" && l.resolve != nil {
+		file.MovieID, file.Provider, file.MatchScore = l.resolve(path, num)
+	}
+
+	return l.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "path"}},
+		UpdateAll: true,
+	}).Create(file).Error
+}
+
+// remove deletes the LocalFile row for path, if any.
+func (l *Library) remove(path string) error {
+	return l.db.Where("path = ?", path).Delete(&model.LocalFile{}).Error
+}
+
+// ResolveLocalFile returns the LocalFile row indexed for path.
+func (l *Library) ResolveLocalFile(path string) (*model.LocalFile, error) {
+	file := new(model.LocalFile)
+	if err := l.db.Where("path = ?", path).First(file).Error; err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+// FindLocalFiles returns every LocalFile matched to movieID/provider.
+func (l *Library) FindLocalFiles(movieID, provider string) ([]*model.LocalFile, error) {
+	var files []*model.LocalFile
+	err := l.db.
+		Where("movie_id = ?", movieID).
+		Where("provider = ?", provider).
+		Find(&files).Error
+	return files, err
+}
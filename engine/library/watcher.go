@@ -0,0 +1,106 @@
+package library
+
+import (
+	"context"
+	"log"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// debounceWindow coalesces bursts of fsnotify events (e.g. a multi-part
+// download finishing) into a single re-index per path. Var, not const, so
+// tests can shrink it.
+var debounceWindow = 2 * time.Second
+
+// Watch watches every configured directory for Create/Rename/Remove events
+// until ctx is canceled, re-indexing changed files incrementally.
+func (l *Library) Watch(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	for _, dir := range l.dirs {
+		if err := watcher.Add(dir); err != nil {
+			return err
+		}
+	}
+
+	pending := &pendingTimers{timers: make(map[string]*time.Timer)}
+	defer pending.stopAll()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if !videoExts[filepath.Ext(event.Name)] {
+				continue
+			}
+			l.debounce(pending, event)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.Printf("library: watcher error: %v", err)
+		}
+	}
+}
+
+// pendingTimers tracks in-flight debounce timers, guarding them against
+// concurrent access from both the watch loop and the timers' own goroutines.
+type pendingTimers struct {
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func (p *pendingTimers) stopAll() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, t := range p.timers {
+		t.Stop()
+	}
+}
+
+// schedule debounces calls for key, invoking fn after debounceWindow unless
+// another schedule call for the same key arrives first, in which case the
+// timer restarts and only the latest fn runs.
+func (p *pendingTimers) schedule(key string, fn func()) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t, ok := p.timers[key]; ok {
+		t.Stop()
+	}
+	p.timers[key] = time.AfterFunc(debounceWindow, func() {
+		p.mu.Lock()
+		delete(p.timers, key)
+		p.mu.Unlock()
+		fn()
+	})
+}
+
+// debounce schedules the event's handling after debounceWindow, resetting
+// the timer if further events for the same path arrive first.
+func (l *Library) debounce(pending *pendingTimers, event fsnotify.Event) {
+	pending.schedule(event.Name, func() { l.handle(event) })
+}
+
+func (l *Library) handle(event fsnotify.Event) {
+	switch {
+	case event.Has(fsnotify.Remove), event.Has(fsnotify.Rename):
+		if err := l.remove(event.Name); err != nil {
+			log.Printf("library: failed to remove %s: %v", event.Name, err)
+		}
+	case event.Has(fsnotify.Create), event.Has(fsnotify.Write):
+		if err := l.indexPath(event.Name); err != nil {
+			log.Printf("library: failed to index %s: %v", event.Name, err)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package library
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPendingTimersScheduleCoalescesBursts(t *testing.T) {
+	orig := debounceWindow
+	debounceWindow = 20 * time.Millisecond
+	defer func() { debounceWindow = orig }()
+
+	p := &pendingTimers{timers: make(map[string]*time.Timer)}
+
+	var calls int32
+	for i := 0; i < 5; i++ {
+		p.schedule("/movies/ABC-123.mp4", func() { atomic.AddInt32(&calls, 1) })
+		time.Sleep(5 * time.Millisecond) // well within the debounce window.
+	}
+
+	time.Sleep(50 * time.Millisecond) // let the final timer fire.
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Fatalf("schedule should coalesce a burst into a single call, got %d", got)
+	}
+}
+
+func TestPendingTimersScheduleDistinctKeysIndependent(t *testing.T) {
+	orig := debounceWindow
+	debounceWindow = 10 * time.Millisecond
+	defer func() { debounceWindow = orig }()
+
+	p := &pendingTimers{timers: make(map[string]*time.Timer)}
+
+	var a, b int32
+	p.schedule("a", func() { atomic.AddInt32(&a, 1) })
+	p.schedule("b", func() { atomic.AddInt32(&b, 1) })
+
+	time.Sleep(30 * time.Millisecond)
+	if atomic.LoadInt32(&a) != 1 || atomic.LoadInt32(&b) != 1 {
+		t.Fatalf("distinct keys should each fire once, got a=%d b=%d", a, b)
+	}
+}
+
+func TestPendingTimersStopAllCancelsPending(t *testing.T) {
+	orig := debounceWindow
+	debounceWindow = 20 * time.Millisecond
+	defer func() { debounceWindow = orig }()
+
+	p := &pendingTimers{timers: make(map[string]*time.Timer)}
+	var calls int32
+	p.schedule("a", func() { atomic.AddInt32(&calls, 1) })
+	p.stopAll()
+
+	time.Sleep(40 * time.Millisecond)
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Fatalf("stopAll should cancel pending timers, but fn ran %d times", got)
+	}
+}
@@ -1,22 +1,41 @@
 package engine
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/javtube/javtube-sdk-go/common/number"
 	"github.com/javtube/javtube-sdk-go/common/priority"
 	"github.com/javtube/javtube-sdk-go/model"
 	javtube "github.com/javtube/javtube-sdk-go/provider"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
 
-func (e *Engine) searchMovie(keyword string, provider javtube.MovieProvider, lazy bool) ([]*model.MovieSearchResult, error) {
+func (e *Engine) searchMovie(keyword string, provider javtube.MovieProvider, opts javtube.SearchOptions) ([]*model.MovieSearchResult, error) {
+	// Providers that understand SearchOptions natively get first refusal.
+	if searcher, ok := provider.(javtube.FilteredMovieSearcher); ok {
+		if info := new(model.MovieInfo); opts.Lazy {
+			if result := applyDBFilters(e.db, opts).
+				Where("provider = ?", provider.Name()).
+				Where(e.db.
+					// Use UPPER to perform case-insensitive match here.
+					// It's inefficient, but it works.
+					Where("UPPER(number) = UPPER(?)", keyword).
+					Or("UPPER(id) = UPPER(?)", keyword)).
+				First(info); result.Error == nil && info.Valid() /* must be valid */ {
+				return []*model.MovieSearchResult{info.ToSearchResult()}, nil
+			} // ignore DB query error.
+		}
+		return searcher.SearchMovie(keyword, opts)
+	}
 	// Regular keyword searching.
 	if searcher, ok := provider.(javtube.MovieSearcher); ok {
 		// Query DB first (by number).
-		if info := new(model.MovieInfo); lazy {
-			if result := e.db.
+		if info := new(model.MovieInfo); opts.Lazy {
+			if result := applyDBFilters(e.db, opts).
 				Where("provider = ?", provider.Name()).
 				Where(e.db.
 					// Use UPPER to perform case-insensitive match here.
@@ -27,17 +46,22 @@ func (e *Engine) searchMovie(keyword string, provider javtube.MovieProvider, laz
 				return []*model.MovieSearchResult{info.ToSearchResult()}, nil
 			} // ignore DB query error.
 		}
-		return searcher.SearchMovie(keyword)
+		results, err := searcher.SearchMovie(keyword)
+		if err != nil {
+			return nil, err
+		}
+		return postFilter(results, keyword, opts), nil
 	}
 	// Fallback to movie info querying.
 	info, err := e.getMovieInfoByID(keyword, provider, true)
 	if err != nil {
 		return nil, err
 	}
-	return []*model.MovieSearchResult{info.ToSearchResult()}, nil
+	return postFilter([]*model.MovieSearchResult{info.ToSearchResult()}, keyword, opts), nil
 }
 
-func (e *Engine) SearchMovie(keyword, name string, lazy bool) ([]*model.MovieSearchResult, error) {
+// SearchMovie searches keyword through the named provider, refined by opts.
+func (e *Engine) SearchMovie(keyword, name string, opts javtube.SearchOptions) ([]*model.MovieSearchResult, error) {
 	if keyword = number.Trim(keyword); keyword == "" {
 		return nil, javtube.ErrInvalidKeyword
 	}
@@ -45,23 +69,39 @@ func (e *Engine) SearchMovie(keyword, name string, lazy bool) ([]*model.MovieSea
 	if !ok {
 		return nil, fmt.Errorf("provider not found: %s", name)
 	}
-	return e.searchMovie(keyword, provider, lazy)
+	return e.searchMovie(keyword, provider, opts)
 }
 
-func (e *Engine) searchMovieAll(keyword string) (results []*model.MovieSearchResult, err error) {
+func (e *Engine) searchMovieAll(keyword string, opts javtube.SearchOptions) (results []*model.MovieSearchResult, err error) {
 	type response struct {
 		Results []*model.MovieSearchResult
 		Error   error
 	}
 	respCh := make(chan response)
 
+	providers := e.movieProviders
+	if len(opts.Providers) > 0 {
+		providers = make(map[string]javtube.MovieProvider, len(opts.Providers))
+		for _, name := range opts.Providers {
+			if provider, ok := e.movieProviders[name]; ok {
+				providers[name] = provider
+			}
+		}
+	}
+
 	var wg sync.WaitGroup
-	for _, provider := range e.movieProviders {
+	for _, provider := range providers {
 		wg.Add(1)
 		// Async searching.
 		go func(provider javtube.MovieProvider) {
 			defer wg.Done()
-			results, err := e.searchMovie(keyword, provider, false)
+			// Respect the provider's configured QPS/Burst so one
+			// slow/rate-limited provider can't stall the whole fan-out.
+			if err := e.limiter(provider.Name()).Wait(context.Background()); err != nil {
+				respCh <- response{Error: err}
+				return
+			}
+			results, err := e.searchMovie(keyword, provider, opts)
 			respCh <- response{
 				Results: results,
 				Error:   err,
@@ -84,8 +124,10 @@ func (e *Engine) searchMovieAll(keyword string) (results []*model.MovieSearchRes
 	return
 }
 
-// SearchMovieAll searches the keyword from all providers.
-func (e *Engine) SearchMovieAll(keyword string, lazy bool) (results []*model.MovieSearchResult, err error) {
+// SearchMovieAll searches the keyword from all providers (or opts.Providers,
+// when set), applying opts as a filter/sort/limit over the aggregated
+// results.
+func (e *Engine) SearchMovieAll(keyword string, opts javtube.SearchOptions) (results []*model.MovieSearchResult, err error) {
 	if keyword = number.Trim(keyword); keyword == "" {
 		return nil, javtube.ErrInvalidKeyword
 	}
@@ -98,35 +140,32 @@ func (e *Engine) SearchMovieAll(keyword string, lazy bool) (results []*model.Mov
 			err = javtube.ErrNotFound
 			return
 		}
-		// post-processing
-		var ps = new(priority.Slice[float64, *model.MovieSearchResult])
-		for _, result := range results {
-			if !result.Valid() /* validation check */ {
-				continue
-			}
-			ps.Append(number.Similarity(keyword, result.Number)*
-				float64(e.movieProviders[result.Provider].Priority()), result)
+		results = sortResults(keyword, results, e.movieProviders, opts)
+		if opts.MaxResults > 0 && len(results) > opts.MaxResults {
+			results = results[:opts.MaxResults]
 		}
-		// sort according to priority.
-		results = ps.Sort().Underlying()
 	}()
 
-	if lazy {
+	if opts.Lazy {
 		var multiInfo = make([]*model.MovieInfo, 0)
-		if result := e.db.
+		if result := applyDBFilters(e.db, opts).
 			// Note: keyword might be an ID or just a regular number, so we should
 			// query both of them for best match. Also, case should not mater.
-			Where("UPPER(number) = UPPER(?)", keyword).
-			Or("UPPER(id) = UPPER(?)", keyword).
+			// The OR must be nested, or GORM ORs it against the whole
+			// accumulated filter chain instead of just this condition.
+			Where(e.db.
+				Where("UPPER(number) = UPPER(?)", keyword).
+				Or("UPPER(id) = UPPER(?)", keyword)).
 			Find(&multiInfo); result.Error == nil && result.RowsAffected > 0 {
 			for _, info := range multiInfo {
 				results = append(results, info.ToSearchResult())
 			}
+			results = postFilter(results, keyword, opts)
 			return
 		}
 	}
 
-	results, err = e.searchMovieAll(keyword)
+	results, err = e.searchMovieAll(keyword, opts)
 	return
 }
 
@@ -147,6 +186,7 @@ func (e *Engine) getMovieInfoByID(id string, provider javtube.MovieProvider, laz
 	// delayed info auto-save.
 	defer func() {
 		if err == nil && info.Valid() {
+			e.mergeSources(info)
 			e.db.Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).Create(info) // ignore error
@@ -155,10 +195,159 @@ func (e *Engine) getMovieInfoByID(id string, provider javtube.MovieProvider, laz
 	return provider.GetMovieInfoByID(id)
 }
 
+// mergeSources unions info.Sources with the sources already cached under
+// info's Number across every provider, so fetching the same title from a
+// second provider adds mirrors instead of discarding the first provider's.
+func (e *Engine) mergeSources(info *model.MovieInfo) {
+	var existing []*model.MovieInfo
+	if result := e.db.
+		Where("UPPER(number) = UPPER(?)", info.Number).
+		Where("provider <> ?", info.Provider).
+		Find(&existing); result.Error != nil || len(existing) == 0 {
+		return
+	}
+	for _, other := range existing {
+		info.Sources = model.MergeSources(info.Sources, other.Sources)
+	}
+}
+
 func (e *Engine) GetMovieInfoByID(id, name string, lazy bool) (info *model.MovieInfo, err error) {
 	provider, ok := e.movieProviders[name]
 	if !ok {
 		return nil, fmt.Errorf("provider not found: %s", name)
 	}
 	return e.getMovieInfoByID(id, provider, lazy)
-}
\ No newline at end of file
+}
+
+// applyDBFilters translates the subset of opts that maps cleanly onto SQL
+// (year range, actress/maker/series, providers) into GORM Where clauses on tx.
+func applyDBFilters(tx *gorm.DB, opts javtube.SearchOptions) *gorm.DB {
+	if opts.YearFrom > 0 {
+		tx = tx.Where("release_date >= ?", fmt.Sprintf("%04d-01-01", opts.YearFrom))
+	}
+	if opts.YearTo > 0 {
+		tx = tx.Where("release_date <= ?", fmt.Sprintf("%04d-12-31", opts.YearTo))
+	}
+	if opts.Actress != "" {
+		tx = tx.Where("actors LIKE ?", "%"+opts.Actress+"%")
+	}
+	if opts.Maker != "" {
+		// Use UPPER to perform case-insensitive match here, matching postFilter.
+		tx = tx.Where("UPPER(maker) = UPPER(?)", opts.Maker)
+	}
+	if opts.Series != "" {
+		tx = tx.Where("UPPER(series) = UPPER(?)", opts.Series)
+	}
+	if len(opts.Providers) > 0 {
+		tx = tx.Where("provider IN ?", opts.Providers)
+	}
+	return tx
+}
+
+// postFilter applies the remaining opts (providers, year range, duration,
+// actress/maker/series, languages, similarity threshold) to results that a
+// provider could not filter natively.
+func postFilter(results []*model.MovieSearchResult, keyword string, opts javtube.SearchOptions) []*model.MovieSearchResult {
+	if opts.MinDuration == 0 && opts.Actress == "" && opts.Maker == "" &&
+		opts.Series == "" && len(opts.Languages) == 0 && opts.SimilarityThreshold == 0 &&
+		len(opts.Providers) == 0 && opts.YearFrom == 0 && opts.YearTo == 0 {
+		return results
+	}
+	filtered := make([]*model.MovieSearchResult, 0, len(results))
+	for _, result := range results {
+		if len(opts.Providers) > 0 && !containsFold(opts.Providers, result.Provider) {
+			continue
+		}
+		if opts.YearFrom > 0 && result.ReleaseDate.Year() < opts.YearFrom {
+			continue
+		}
+		if opts.YearTo > 0 && result.ReleaseDate.Year() > opts.YearTo {
+			continue
+		}
+		if opts.MinDuration > 0 && result.Duration < opts.MinDuration {
+			continue
+		}
+		if opts.SimilarityThreshold > 0 && number.Similarity(keyword, result.Number) < opts.SimilarityThreshold {
+			continue
+		}
+		if opts.Actress != "" && !containsFold(result.Actors, opts.Actress) {
+			continue
+		}
+		if opts.Maker != "" && !strings.EqualFold(result.Maker, opts.Maker) {
+			continue
+		}
+		if opts.Series != "" && !strings.EqualFold(result.Series, opts.Series) {
+			continue
+		}
+		if len(opts.Languages) > 0 && !intersectsFold(result.Languages, opts.Languages) {
+			continue
+		}
+		filtered = append(filtered, result)
+	}
+	return filtered
+}
+
+// containsFold reports whether values contains s, case-insensitively.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectsFold reports whether a and b share at least one element,
+// case-insensitively.
+func intersectsFold(a, b []string) bool {
+	for _, s := range b {
+		if containsFold(a, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortResults orders results according to opts.SortBy, defaulting to the
+// existing priority-weighted similarity ranking.
+func sortResults(keyword string, results []*model.MovieSearchResult, providers map[string]javtube.MovieProvider, opts javtube.SearchOptions) []*model.MovieSearchResult {
+	switch opts.SortBy {
+	case javtube.SortByDate:
+		var ps = new(priority.Slice[int64, *model.MovieSearchResult])
+		for _, result := range results {
+			if !result.Valid() {
+				continue
+			}
+			ps.Append(result.ReleaseDate.Unix(), result)
+		}
+		return ps.Sort().Underlying()
+	case javtube.SortByRating:
+		var ps = new(priority.Slice[float64, *model.MovieSearchResult])
+		for _, result := range results {
+			if !result.Valid() {
+				continue
+			}
+			ps.Append(result.Score, result)
+		}
+		return ps.Sort().Underlying()
+	case javtube.SortBySimilarity:
+		var ps = new(priority.Slice[float64, *model.MovieSearchResult])
+		for _, result := range results {
+			if !result.Valid() {
+				continue
+			}
+			ps.Append(number.Similarity(keyword, result.Number), result)
+		}
+		return ps.Sort().Underlying()
+	default: // javtube.SortByPriority and unset.
+		var ps = new(priority.Slice[float64, *model.MovieSearchResult])
+		for _, result := range results {
+			if !result.Valid() /* validation check */ {
+				continue
+			}
+			ps.Append(number.Similarity(keyword, result.Number)*
+				float64(providers[result.Provider].Priority()), result)
+		}
+		return ps.Sort().Underlying()
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/javtube/javtube-sdk-go/model"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+)
+
+func TestPostFilterNoOpts(t *testing.T) {
+	results := []*model.MovieSearchResult{{ID: "1", Number: "ABC-123", Provider: "P"}}
+	if got := postFilter(results, "ABC-123", javtube.SearchOptions{}); len(got) != len(results) {
+		t.Fatalf("zero-value opts must be a no-op, got %d results, want %d", len(got), len(results))
+	}
+}
+
+func TestPostFilterActressMakerSeriesLanguages(t *testing.T) {
+	results := []*model.MovieSearchResult{
+		{ID: "1", Number: "ABC-001", Provider: "P", Actors: []string{"Alice"}, Maker: "Acme", Series: "Foo", Languages: []string{"ja"}},
+		{ID: "2", Number: "ABC-002", Provider: "P", Actors: []string{"Bob"}, Maker: "Acme", Series: "Bar", Languages: []string{"en"}},
+		{ID: "3", Number: "ABC-003", Provider: "P", Actors: []string{"alice"}, Maker: "other", Series: "Foo", Languages: []string{"EN", "ja"}},
+	}
+
+	got := postFilter(results, "ABC", javtube.SearchOptions{Actress: "alice"})
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "3" {
+		t.Fatalf("Actress filter (case-insensitive) = %+v", got)
+	}
+
+	got = postFilter(results, "ABC", javtube.SearchOptions{Maker: "acme"})
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "2" {
+		t.Fatalf("Maker filter (case-insensitive) = %+v", got)
+	}
+
+	got = postFilter(results, "ABC", javtube.SearchOptions{Series: "foo"})
+	if len(got) != 2 || got[0].ID != "1" || got[1].ID != "3" {
+		t.Fatalf("Series filter (case-insensitive) = %+v", got)
+	}
+
+	got = postFilter(results, "ABC", javtube.SearchOptions{Languages: []string{"en"}})
+	if len(got) != 2 || got[0].ID != "2" || got[1].ID != "3" {
+		t.Fatalf("Languages filter (intersection, case-insensitive) = %+v", got)
+	}
+}
+
+func TestPostFilterProviders(t *testing.T) {
+	results := []*model.MovieSearchResult{
+		{ID: "1", Number: "ABC-001", Provider: "X"},
+		{ID: "2", Number: "ABC-002", Provider: "Y"},
+		{ID: "3", Number: "ABC-003", Provider: "y"},
+	}
+	got := postFilter(results, "ABC", javtube.SearchOptions{Providers: []string{"Y"}})
+	if len(got) != 2 || got[0].ID != "2" || got[1].ID != "3" {
+		t.Fatalf("Providers filter (case-insensitive) = %+v", got)
+	}
+}
+
+func TestPostFilterYearRange(t *testing.T) {
+	results := []*model.MovieSearchResult{
+		{ID: "2010", Number: "A-1", Provider: "P", ReleaseDate: time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "2015", Number: "A-2", Provider: "P", ReleaseDate: time.Date(2015, 6, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: "2020", Number: "A-3", Provider: "P", ReleaseDate: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	got := postFilter(results, "A", javtube.SearchOptions{YearFrom: 2015})
+	if len(got) != 2 || got[0].ID != "2015" || got[1].ID != "2020" {
+		t.Fatalf("YearFrom filter = %+v", got)
+	}
+
+	got = postFilter(results, "A", javtube.SearchOptions{YearTo: 2015})
+	if len(got) != 2 || got[0].ID != "2010" || got[1].ID != "2015" {
+		t.Fatalf("YearTo filter = %+v", got)
+	}
+
+	got = postFilter(results, "A", javtube.SearchOptions{YearFrom: 2012, YearTo: 2018})
+	if len(got) != 1 || got[0].ID != "2015" {
+		t.Fatalf("YearFrom/YearTo filter = %+v", got)
+	}
+}
+
+func TestPostFilterMinDuration(t *testing.T) {
+	results := []*model.MovieSearchResult{
+		{ID: "short", Number: "A-1", Provider: "P", Duration: 30 * time.Minute},
+		{ID: "long", Number: "A-2", Provider: "P", Duration: 120 * time.Minute},
+	}
+	got := postFilter(results, "A", javtube.SearchOptions{MinDuration: time.Hour})
+	if len(got) != 1 || got[0].ID != "long" {
+		t.Fatalf("MinDuration filter = %+v", got)
+	}
+}
+
+func TestContainsFoldAndIntersectsFold(t *testing.T) {
+	if !containsFold([]string{"Alice", "Bob"}, "alice") {
+		t.Error("containsFold should be case-insensitive")
+	}
+	if containsFold([]string{"Alice"}, "carol") {
+		t.Error("containsFold matched an absent value")
+	}
+	if !intersectsFold([]string{"en", "ja"}, []string{"JA"}) {
+		t.Error("intersectsFold should be case-insensitive")
+	}
+	if intersectsFold([]string{"en"}, []string{"fr", "de"}) {
+		t.Error("intersectsFold matched with no common element")
+	}
+}
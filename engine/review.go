@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/javtube/javtube-sdk-go/model"
+	javtube "github.com/javtube/javtube-sdk-go/provider"
+	"gorm.io/gorm/clause"
+)
+
+// RegisterReviewer adds a review-only scraper keyed by name, for providers
+// that don't implement the full MovieProvider interface (e.g. they only
+// scrape reviews, not search or metadata). A movieProvider that happens to
+// implement javtube.MovieReviewer is picked up automatically and does not
+// need to be registered here too.
+func (e *Engine) RegisterReviewer(name string, reviewer javtube.MovieReviewer) {
+	e.reviewers[name] = reviewer
+}
+
+// reviewerByName returns the MovieReviewer registered under name, whether
+// that's a dedicated entry in e.reviewers or a movieProvider that also
+// implements javtube.MovieReviewer.
+func (e *Engine) reviewerByName(name string) (javtube.MovieReviewer, bool) {
+	if reviewer, ok := e.reviewers[name]; ok {
+		return reviewer, true
+	}
+	if provider, ok := e.movieProviders[name]; ok {
+		if reviewer, ok := provider.(javtube.MovieReviewer); ok {
+			return reviewer, true
+		}
+	}
+	return nil, false
+}
+
+// allReviewers returns every registered reviewer keyed by provider name,
+// merging e.reviewers with any movieProviders that implement MovieReviewer.
+func (e *Engine) allReviewers() map[string]javtube.MovieReviewer {
+	all := make(map[string]javtube.MovieReviewer, len(e.reviewers)+len(e.movieProviders))
+	for name, reviewer := range e.reviewers {
+		all[name] = reviewer
+	}
+	for name, provider := range e.movieProviders {
+		if reviewer, ok := provider.(javtube.MovieReviewer); ok {
+			all[name] = reviewer
+		}
+	}
+	return all
+}
+
+func (e *Engine) getMovieReviews(id, name string, reviewer javtube.MovieReviewer, lazy bool) (reviews []*model.MovieReview, err error) {
+	if lazy {
+		if result := e.db.
+			Where("movie_id = ?", id).
+			Where("provider = ?", name).
+			Find(&reviews); result.Error == nil && result.RowsAffected > 0 {
+			return reviews, nil
+		} // ignore DB query error.
+	}
+	if reviews, err = reviewer.GetMovieReviews(id); err != nil {
+		return nil, err
+	}
+	// Cache, upserting by URL so re-fetches never duplicate a row.
+	for _, review := range reviews {
+		e.db.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "url"}},
+			UpdateAll: true,
+		}).Create(review) // ignore error
+	}
+	return reviews, nil
+}
+
+// GetMovieReviews returns the reviews for id from the named provider.
+func (e *Engine) GetMovieReviews(id, name string, lazy bool) ([]*model.MovieReview, error) {
+	reviewer, ok := e.reviewerByName(name)
+	if !ok {
+		return nil, fmt.Errorf("provider does not support reviews: %s", name)
+	}
+	return e.getMovieReviews(id, name, reviewer, lazy)
+}
+
+// GetMovieReviewsAll fans out to every provider that supports reviews for
+// id, mirroring searchMovieAll's goroutine/fan-in pattern, and returns the
+// aggregated reviews sorted by provider priority, most recent first within
+// a provider. Reviewers that aren't also registered movieProviders (and so
+// have no Priority()) sort as priority 0.
+func (e *Engine) GetMovieReviewsAll(id string, lazy bool) (reviews []*model.MovieReview, err error) {
+	type response struct {
+		Reviews []*model.MovieReview
+		Error   error
+	}
+	respCh := make(chan response)
+
+	var wg sync.WaitGroup
+	for name, reviewer := range e.allReviewers() {
+		wg.Add(1)
+		go func(name string, reviewer javtube.MovieReviewer) {
+			defer wg.Done()
+			reviews, err := e.getMovieReviews(id, name, reviewer, lazy)
+			respCh <- response{Reviews: reviews, Error: err}
+		}(name, reviewer)
+	}
+	go func() {
+		wg.Wait()
+		close(respCh)
+	}()
+
+	for resp := range respCh {
+		if resp.Error != nil {
+			continue
+		}
+		reviews = append(reviews, resp.Reviews...)
+	}
+	if len(reviews) == 0 {
+		return nil, javtube.ErrNotFound
+	}
+
+	priority := func(name string) int {
+		if provider, ok := e.movieProviders[name]; ok {
+			return provider.Priority()
+		}
+		return 0
+	}
+	sort.SliceStable(reviews, func(i, j int) bool {
+		if pi, pj := priority(reviews[i].Provider), priority(reviews[j].Provider); pi != pj {
+			return pi > pj
+		}
+		return reviews[i].PostedAt.After(reviews[j].PostedAt)
+	})
+	return reviews, nil
+}
@@ -0,0 +1,19 @@
+package engine
+
+import "github.com/javtube/javtube-sdk-go/model"
+
+// GetMovieSources aggregates the playback sources cached for number across
+// every provider, matching number case-insensitively via UPPER(number).
+func (e *Engine) GetMovieSources(number string) ([]model.MovieSource, error) {
+	var infos []*model.MovieInfo
+	if err := e.db.
+		Where("UPPER(number) = UPPER(?)", number).
+		Find(&infos).Error; err != nil {
+		return nil, err
+	}
+	var sources []model.MovieSource
+	for _, info := range infos {
+		sources = model.MergeSources(sources, info.Sources)
+	}
+	return sources, nil
+}
@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// JobStatus represents the lifecycle state of a queued Job.
+type JobStatus string
+
+const (
+	JobStatusPending JobStatus = "pending"
+	JobStatusRunning JobStatus = "running"
+	JobStatusDone    JobStatus = "done"
+	JobStatusFailed  JobStatus = "failed"
+)
+
+// Job is a durable unit of background work processed by engine/jobs. Rows
+// are claimed by workers, executed, and either marked done or rescheduled
+// with backoff on failure; after too many failed attempts a row is parked as
+// JobStatusFailed until it is requeued.
+type Job struct {
+	ID         uint64    `gorm:"primaryKey"`
+	Kind       string    `gorm:"index;not null"`
+	Payload    string    `gorm:"type:text"` // JSON-encoded handler arguments.
+	Status     JobStatus `gorm:"index;not null;default:pending"`
+	Attempts   int
+	RunAfter   time.Time `gorm:"index"`
+	LastError  string
+	ClaimToken string `gorm:"index"` // set while a worker holds the row.
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (Job) TableName() string {
+	return "jobs"
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// LocalFile records a video file discovered on disk by engine/library and
+// the metadata it was matched against, if any.
+type LocalFile struct {
+	ID    uint64 `gorm:"primaryKey"`
+	Path  string `gorm:"uniqueIndex;not null"`
+	Size  int64
+	MTime time.Time
+
+	MovieID    string `gorm:"index"`
+	Provider   string `gorm:"index"`
+	MatchScore float64
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (LocalFile) TableName() string {
+	return "local_files"
+}
@@ -0,0 +1,106 @@
+package model
+
+import "time"
+
+// MovieInfo is the canonical, cached metadata for a single movie, keyed by
+// (Provider, ID), with a plain index on number; engine/movie.go looks it up
+// case-insensitively by UPPER(number).
+type MovieInfo struct {
+	ID       string `gorm:"primaryKey"`
+	Provider string `gorm:"primaryKey"`
+	Number   string `gorm:"index"`
+
+	Title    string
+	Summary  string
+	Director string
+	Actors   []string `gorm:"serializer:json"`
+	Maker    string
+	Series   string
+
+	// Languages lists the audio/subtitle languages available for this
+	// title, used by SearchOptions.Languages post-filtering.
+	Languages []string `gorm:"serializer:json"`
+	Tags      []string `gorm:"serializer:json"`
+
+	Score       float64
+	ReleaseDate time.Time
+	Duration    time.Duration
+
+	Homepage string
+	ThumbURL string
+	CoverURL string
+
+	// Sources lists alternative playback candidates (mirrors, qualities,
+	// CDNs) for this title, unioned across providers on fetch; see
+	// Engine.mergeSources.
+	Sources []MovieSource `gorm:"serializer:json"`
+
+	// VendorInfo carries provider-specific payloads (DMM content ID,
+	// Bilibili BVID, region) that don't fit the common schema above.
+	VendorInfo `gorm:"embedded;embeddedPrefix:vendor_"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (MovieInfo) TableName() string {
+	return "movie_infos"
+}
+
+// Valid reports whether info has the minimum fields required to be cached
+// and served.
+func (info *MovieInfo) Valid() bool {
+	return info != nil && info.ID != "" && info.Number != "" && info.Provider != ""
+}
+
+// ToSearchResult projects info down to the lighter-weight MovieSearchResult
+// returned by search APIs.
+func (info *MovieInfo) ToSearchResult() *MovieSearchResult {
+	return &MovieSearchResult{
+		ID:          info.ID,
+		Number:      info.Number,
+		Provider:    info.Provider,
+		Title:       info.Title,
+		Actors:      info.Actors,
+		Maker:       info.Maker,
+		Series:      info.Series,
+		Languages:   info.Languages,
+		Score:       info.Score,
+		ReleaseDate: info.ReleaseDate,
+		Duration:    info.Duration,
+		Homepage:    info.Homepage,
+		ThumbURL:    info.ThumbURL,
+		CoverURL:    info.CoverURL,
+		Sources:     info.Sources,
+	}
+}
+
+// MovieSearchResult is the lightweight projection of MovieInfo returned by
+// SearchMovie/SearchMovieAll.
+type MovieSearchResult struct {
+	ID       string
+	Number   string
+	Provider string
+	Title    string
+
+	Actors    []string
+	Maker     string
+	Series    string
+	Languages []string
+
+	Score       float64
+	ReleaseDate time.Time
+	Duration    time.Duration
+
+	Homepage string
+	ThumbURL string
+	CoverURL string
+
+	Sources []MovieSource
+}
+
+// Valid reports whether result has the minimum fields required to be
+// returned to a caller.
+func (result *MovieSearchResult) Valid() bool {
+	return result != nil && result.ID != "" && result.Number != "" && result.Provider != ""
+}
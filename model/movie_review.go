@@ -0,0 +1,21 @@
+package model
+
+import "time"
+
+// MovieReview is a single review scraped from a provider's movie page and
+// cached in the DB, upserted by URL so re-fetching never duplicates a row.
+type MovieReview struct {
+	ID       uint64 `gorm:"primaryKey"`
+	MovieID  string `gorm:"index;not null"`
+	Provider string `gorm:"index;not null"`
+	Source   string // the site the review was scraped from, when it differs from Provider.
+	URL      string `gorm:"uniqueIndex;not null"`
+	Author   string
+	Rating   float64
+	Body     string `gorm:"type:text"`
+	PostedAt time.Time
+}
+
+func (MovieReview) TableName() string {
+	return "movie_reviews"
+}
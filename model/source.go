@@ -0,0 +1,43 @@
+package model
+
+// MovieSource is a single playback candidate for a movie: a mirror, quality
+// tier, or CDN, distinct from the provider's own canonical homepage/URL.
+// MovieInfo and MovieSearchResult each carry a `Sources []MovieSource`
+// field.
+type MovieSource struct {
+	Name     string
+	Type     string // e.g. "stream", "download", "torrent".
+	URL      string
+	Headers  map[string]string
+	Priority int
+}
+
+// VendorInfo carries provider-specific payloads that don't fit the common
+// MovieInfo schema, such as a DMM content ID or a Bilibili BVID. MovieInfo
+// embeds it directly.
+type VendorInfo struct {
+	DMMContentID string
+	BilibiliBVID string
+	Region       string
+}
+
+// MergeSources unions a and b by URL, preferring a's copy of a source that
+// appears in both (e.g. when a was freshly scraped and b is the cached
+// value being merged into).
+func MergeSources(a, b []MovieSource) []MovieSource {
+	seen := make(map[string]bool, len(a)+len(b))
+	merged := make([]MovieSource, 0, len(a)+len(b))
+	for _, s := range a {
+		if !seen[s.URL] {
+			seen[s.URL] = true
+			merged = append(merged, s)
+		}
+	}
+	for _, s := range b {
+		if !seen[s.URL] {
+			seen[s.URL] = true
+			merged = append(merged, s)
+		}
+	}
+	return merged
+}
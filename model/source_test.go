@@ -0,0 +1,40 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeSources(t *testing.T) {
+	a := []MovieSource{
+		{Name: "mirror-a", URL: "https://a.example/1"},
+		{Name: "mirror-shared", URL: "https://shared.example/1"},
+	}
+	b := []MovieSource{
+		{Name: "mirror-shared-stale", URL: "https://shared.example/1"}, // duplicate URL, a's copy wins.
+		{Name: "mirror-b", URL: "https://b.example/1"},
+	}
+
+	got := MergeSources(a, b)
+	want := []MovieSource{
+		{Name: "mirror-a", URL: "https://a.example/1"},
+		{Name: "mirror-shared", URL: "https://shared.example/1"},
+		{Name: "mirror-b", URL: "https://b.example/1"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("MergeSources(a, b) = %+v, want %+v", got, want)
+	}
+}
+
+func TestMergeSourcesEmptyInputs(t *testing.T) {
+	if got := MergeSources(nil, nil); len(got) != 0 {
+		t.Fatalf("MergeSources(nil, nil) = %+v, want empty", got)
+	}
+	only := []MovieSource{{Name: "only", URL: "https://only.example"}}
+	if got := MergeSources(only, nil); !reflect.DeepEqual(got, only) {
+		t.Fatalf("MergeSources(only, nil) = %+v, want %+v", got, only)
+	}
+	if got := MergeSources(nil, only); !reflect.DeepEqual(got, only) {
+		t.Fatalf("MergeSources(nil, only) = %+v, want %+v", got, only)
+	}
+}
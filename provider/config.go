@@ -0,0 +1,16 @@
+package javtube
+
+// Config holds per-provider configuration — API keys, cookies, proxy URLs,
+// rate limits — keyed by provider name and supplied at Engine construction
+// (or hot-swapped via Engine.SetProviderConfig) instead of relying on
+// package-level globals.
+type Config map[string]map[string]any
+
+// ConfigurableMovieProvider is implemented by providers that accept runtime
+// configuration instead of reading credentials from package-level globals.
+// Engine calls Configure once at registration and again on every
+// SetProviderConfig.
+type ConfigurableMovieProvider interface {
+	MovieProvider
+	Configure(cfg map[string]any) error
+}
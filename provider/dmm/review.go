@@ -0,0 +1,67 @@
+// Package dmm implements review scraping against DMM's per-title review
+// ("クチコミ") section.
+package dmm
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/javtube/javtube-sdk-go/common/review"
+	"github.com/javtube/javtube-sdk-go/model"
+)
+
+const reviewsURL = "https://www.dmm.co.jp/mono/dvd/-/detail/=/cid=%s/"
+
+// Provider scrapes DMM movie reviews. It implements javtube.MovieReviewer.
+type Provider struct {
+	Client *http.Client
+}
+
+// New returns a DMM review Provider using http.DefaultClient.
+func New() *Provider {
+	return &Provider{Client: http.DefaultClient}
+}
+
+func (p *Provider) Name() string { return "DMM" }
+
+// GetMovieReviews fetches and parses the review list for the DMM content id.
+func (p *Provider) GetMovieReviews(id string) ([]*model.MovieReview, error) {
+	resp, err := p.Client.Get(fmt.Sprintf(reviewsURL, id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews []*model.MovieReview
+	doc.Find(".reviewerUnitArea").Each(func(i int, s *goquery.Selection) {
+		rating, body := review.ScrubReview(
+			s.Find(".reviewPoint").Text() + " " + s.Find("p.mg-b20").Text())
+		reviews = append(reviews, &model.MovieReview{
+			MovieID:  id,
+			Provider: p.Name(),
+			Source:   "DMM",
+			URL:      fmt.Sprintf(reviewsURL, id) + "#" + reviewAnchor(s, i),
+			Author:   s.Find(".reviewerName").Text(),
+			Rating:   rating,
+			Body:     body,
+		})
+	})
+	return reviews, nil
+}
+
+// reviewAnchor returns a stable per-review identifier for use as a URL
+// fragment, mirroring provider/javlibrary's commentAnchor: it prefers the
+// review element's own id attribute and falls back to its position on the
+// page (i, 0-based) so reviews never collide on the same fragment/URL.
+func reviewAnchor(s *goquery.Selection, i int) string {
+	if id, ok := s.Attr("id"); ok && id != "" {
+		return id
+	}
+	return fmt.Sprintf("review-%d", i)
+}
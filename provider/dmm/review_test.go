@@ -0,0 +1,42 @@
+package dmm
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestReviewAnchor(t *testing.T) {
+	cases := []struct {
+		name string
+		html string
+		i    int
+		want string
+	}{
+		{
+			name: "prefers id attribute",
+			html: `<div id="review_9"></div>`,
+			i:    2,
+			want: "review_9",
+		},
+		{
+			name: "falls back to position when id is absent",
+			html: `<div></div>`,
+			i:    2,
+			want: "review-2",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(c.html))
+			if err != nil {
+				t.Fatalf("NewDocumentFromReader() error = %v", err)
+			}
+			s := doc.Find("div").First()
+			if got := reviewAnchor(s, c.i); got != c.want {
+				t.Errorf("reviewAnchor() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
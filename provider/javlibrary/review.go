@@ -0,0 +1,76 @@
+// Package javlibrary implements review scraping against JAVLibrary's
+// per-title comment sections.
+package javlibrary
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/javtube/javtube-sdk-go/common/review"
+	"github.com/javtube/javtube-sdk-go/model"
+)
+
+const reviewsURL = "https://www.javlibrary.com/en/?v=%s"
+
+// Provider scrapes JAVLibrary movie comments/reviews. It implements
+// javtube.MovieReviewer.
+type Provider struct {
+	Client *http.Client
+}
+
+// New returns a JAVLibrary review Provider using http.DefaultClient.
+func New() *Provider {
+	return &Provider{Client: http.DefaultClient}
+}
+
+func (p *Provider) Name() string { return "JAVLIBRARY" }
+
+// GetMovieReviews fetches and parses the comment list for the JAVLibrary
+// title id.
+func (p *Provider) GetMovieReviews(id string) ([]*model.MovieReview, error) {
+	resp, err := p.Client.Get(fmt.Sprintf(reviewsURL, id))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviews []*model.MovieReview
+	doc.Find("#comments .comment").Each(func(i int, s *goquery.Selection) {
+		rating, body := review.ScrubReview(
+			s.Find(".score").Text() + " " + s.Find(".comment_body").Text())
+		reviews = append(reviews, &model.MovieReview{
+			MovieID:  id,
+			Provider: p.Name(),
+			Source:   "JAVLibrary",
+			URL:      fmt.Sprintf(reviewsURL, id) + "#" + commentAnchor(s, i),
+			Author:   s.Find(".comment_author").Text(),
+			Rating:   rating,
+			Body:     body,
+		})
+	})
+	return reviews, nil
+}
+
+// commentAnchor returns a stable per-comment identifier for use as a URL
+// fragment, so the same comment maps to the same fragment across re-scrapes
+// even if JAVLibrary reorders the comment list. It prefers the comment
+// element's own id attribute (JAVLibrary renders these as "comment_<cid>")
+// and falls back to the permalink anchor's href if the id is absent. If
+// neither is present, it falls back to the comment's position in the page
+// (i, 0-based) so comments never collide on the same fragment/URL.
+func commentAnchor(s *goquery.Selection, i int) string {
+	if id, ok := s.Attr("id"); ok && id != "" {
+		return id
+	}
+	if href, ok := s.Find("a.permalink").Attr("href"); ok && href != "" {
+		return strings.TrimPrefix(href, "#")
+	}
+	return fmt.Sprintf("comment-%d", i)
+}
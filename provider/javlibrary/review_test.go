@@ -0,0 +1,63 @@
+package javlibrary
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestCommentAnchor(t *testing.T) {
+	cases := []struct {
+		name string
+		html string
+		i    int
+		want string
+	}{
+		{
+			name: "prefers id attribute",
+			html: `<div id="comment_42"><a class="permalink" href="#permalink_7"></a></div>`,
+			i:    3,
+			want: "comment_42",
+		},
+		{
+			name: "falls back to permalink href",
+			html: `<div><a class="permalink" href="#permalink_7"></a></div>`,
+			i:    3,
+			want: "permalink_7",
+		},
+		{
+			name: "falls back to position when neither is present",
+			html: `<div></div>`,
+			i:    3,
+			want: "comment-3",
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			doc, err := goquery.NewDocumentFromReader(strings.NewReader(c.html))
+			if err != nil {
+				t.Fatalf("NewDocumentFromReader() error = %v", err)
+			}
+			s := doc.Find("div").First()
+			if got := commentAnchor(s, c.i); got != c.want {
+				t.Errorf("commentAnchor() = %q, want %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCommentAnchorFallbackIsUniquePerComment(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(
+		`<div class="comment"></div><div class="comment"></div>`))
+	if err != nil {
+		t.Fatalf("NewDocumentFromReader() error = %v", err)
+	}
+	var anchors []string
+	doc.Find(".comment").Each(func(i int, s *goquery.Selection) {
+		anchors = append(anchors, commentAnchor(s, i))
+	})
+	if len(anchors) != 2 || anchors[0] == anchors[1] {
+		t.Fatalf("commentAnchor() fallback anchors must be unique per comment, got %v", anchors)
+	}
+}
@@ -0,0 +1,9 @@
+package javtube
+
+import "github.com/javtube/javtube-sdk-go/model"
+
+// MovieReviewer is implemented by providers that expose user reviews for a
+// movie, in addition to (or instead of) plain metadata.
+type MovieReviewer interface {
+	GetMovieReviews(id string) ([]*model.MovieReview, error)
+}
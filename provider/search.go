@@ -0,0 +1,44 @@
+package javtube
+
+import (
+	"time"
+
+	"github.com/javtube/javtube-sdk-go/model"
+)
+
+// SortBy selects how SearchMovieAll orders its aggregated results.
+type SortBy string
+
+const (
+	SortByPriority   SortBy = "priority"
+	SortBySimilarity SortBy = "similarity"
+	SortByDate       SortBy = "date"
+	SortByRating     SortBy = "rating"
+)
+
+// SearchOptions refines a keyword search with quality, locale, and fan-out
+// controls. The zero value behaves like a plain, unfiltered keyword search.
+type SearchOptions struct {
+	Lazy bool
+
+	YearFrom, YearTo int
+	MinDuration      time.Duration
+
+	Actress string
+	Maker   string
+	Series  string
+
+	Languages []string
+	Providers []string // restricts the provider fan-out when non-empty.
+
+	SimilarityThreshold float64
+	MaxResults          int
+	SortBy              SortBy
+}
+
+// FilteredMovieSearcher is implemented by providers that can apply
+// SearchOptions natively (e.g. as query parameters) instead of relying on
+// the engine's generic post-filtering.
+type FilteredMovieSearcher interface {
+	SearchMovie(keyword string, opts SearchOptions) ([]*model.MovieSearchResult, error)
+}